@@ -0,0 +1,21 @@
+package counter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker prunes History entries older than Params.HistoryWindowBlocks,
+// so the keyspace doubling as on-chain telemetry doesn't grow unbounded.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	windowBlocks := k.GetParams(ctx).HistoryWindowBlocks
+	if windowBlocks <= 0 {
+		return
+	}
+
+	cutoff := ctx.BlockHeight() - windowBlocks
+	if cutoff <= 0 {
+		return
+	}
+
+	k.PruneHistoryBefore(ctx, cutoff)
+}
@@ -0,0 +1,208 @@
+package ante
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	counter "github.com/pavlenkotm/tutorials-account-balances/examples/go/cosmos-module"
+	"github.com/pavlenkotm/tutorials-account-balances/examples/go/cosmos-module/eip712"
+)
+
+// testDomain is the EIP-712 domain used throughout this file.
+func testDomain() eip712.Domain {
+	return eip712.Domain{
+		Name:              "counter",
+		Version:           "1",
+		ChainId:           sdk.NewInt(9000).BigInt(),
+		VerifyingContract: common.HexToAddress("0x0000000000000000000000000000000000c0de"),
+	}
+}
+
+// newTestKeeper spins up a counter Keeper backed by an in-memory store.
+func newTestKeeper(t *testing.T) (counter.Keeper, sdk.Context) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(counter.StoreKey)
+	ms := storetypes.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, dbm.NewMemDB())
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatalf("failed to load store: %v", err)
+	}
+
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Now()}, false, log.NewNopLogger())
+	k := counter.NewKeeper(codec.NewProtoCodec(nil), storeKey)
+
+	return k, ctx
+}
+
+// fakeTx is a minimal sdk.Tx + HasExtensionOptionsTx implementation, the
+// same shape a real TxBuilder produces once it has extension options set.
+type fakeTx struct {
+	msgs    []sdk.Msg
+	extOpts []*codectypes.Any
+}
+
+func (tx fakeTx) GetMsgs() []sdk.Msg                     { return tx.msgs }
+func (tx fakeTx) ValidateBasic() error                   { return nil }
+func (tx fakeTx) GetExtensionOptions() []*codectypes.Any { return tx.extOpts }
+
+// noopNext is an sdk.AnteHandler that just returns ctx unchanged; tests use
+// it to observe AnteHandle's own return value in isolation.
+func noopNext(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func ethereumCounterTx(t *testing.T, payload *counter.CounterTxPayload) fakeTx {
+	t.Helper()
+
+	data, err := counter.EncodeCounterTxPayload(payload)
+	if err != nil {
+		t.Fatalf("EncodeCounterTxPayload() error = %v", err)
+	}
+
+	extAny, err := codectypes.NewAnyWithValue(&counter.ExtensionOptionsEthereumCounterTx{})
+	if err != nil {
+		t.Fatalf("NewAnyWithValue() error = %v", err)
+	}
+
+	return fakeTx{
+		msgs:    []sdk.Msg{&counter.MsgEthereumCounterTx{Data: data}},
+		extOpts: []*codectypes.Any{extAny},
+	}
+}
+
+// TestHasEthereumCounterExtension_NewAnyWithValue builds the extension
+// option via codectypes.NewAnyWithValue, the same path client/cli/tx.go
+// uses, and confirms hasEthereumCounterExtension recognizes it. This only
+// passes because ExtensionOptionsEthereumCounterTx registers itself with
+// gogoproto's type registry (tx_extension.go); without that,
+// NewAnyWithValue derives TypeUrl "/" and this tx would silently bypass
+// EthereumCounterTxDecorator entirely.
+func TestHasEthereumCounterExtension_NewAnyWithValue(t *testing.T) {
+	extAny, err := codectypes.NewAnyWithValue(&counter.ExtensionOptionsEthereumCounterTx{})
+	if err != nil {
+		t.Fatalf("NewAnyWithValue() error = %v", err)
+	}
+
+	if extAny.TypeUrl != counter.ExtensionOptionsEthereumCounterTxTypeURL {
+		t.Fatalf("TypeUrl = %q, want %q", extAny.TypeUrl, counter.ExtensionOptionsEthereumCounterTxTypeURL)
+	}
+
+	tx := fakeTx{extOpts: []*codectypes.Any{extAny}}
+	if !hasEthereumCounterExtension(tx) {
+		t.Fatal("hasEthereumCounterExtension() = false, want true")
+	}
+}
+
+func TestAnteHandle_WrongNonceRejected(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	d := NewEthereumCounterTxDecorator(k, testDomain())
+
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	addr := crypto.PubkeyToAddress(privKey.PublicKey)
+	k.SetNonce(ctx, addr, 5)
+
+	payload, err := counter.SignCounterTxPayload(privKey, counter.ActionIncrement, 0, 3)
+	if err != nil {
+		t.Fatalf("SignCounterTxPayload() error = %v", err)
+	}
+
+	_, err = d.AnteHandle(ctx, ethereumCounterTx(t, payload), false, noopNext)
+	if err == nil {
+		t.Fatal("AnteHandle() error = nil, want non-nil for wrong nonce")
+	}
+}
+
+func TestAnteHandle_ReplayedNonceRejected(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	d := NewEthereumCounterTxDecorator(k, testDomain())
+
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	payload, err := counter.SignCounterTxPayload(privKey, counter.ActionIncrement, 0, 0)
+	if err != nil {
+		t.Fatalf("SignCounterTxPayload() error = %v", err)
+	}
+
+	if _, err := d.AnteHandle(ctx, ethereumCounterTx(t, payload), false, noopNext); err != nil {
+		t.Fatalf("first AnteHandle() error = %v, want nil", err)
+	}
+
+	// Replaying the exact same (already-consumed) nonce must be rejected.
+	if _, err := d.AnteHandle(ctx, ethereumCounterTx(t, payload), false, noopNext); err == nil {
+		t.Fatal("replayed AnteHandle() error = nil, want non-nil")
+	}
+}
+
+func TestAnteHandle_BadSignatureRejected(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	d := NewEthereumCounterTxDecorator(k, testDomain())
+
+	payload := &counter.CounterTxPayload{
+		Action: counter.ActionIncrement,
+		Nonce:  0,
+		V:      big.NewInt(27),
+		R:      big.NewInt(0), // invalid: Signature() requires R.Sign() > 0
+		S:      big.NewInt(1),
+	}
+
+	_, err := d.AnteHandle(ctx, ethereumCounterTx(t, payload), false, noopNext)
+	if err == nil {
+		t.Fatal("AnteHandle() error = nil, want non-nil for invalid R/S")
+	}
+}
+
+// TestAnteHandle_EIP712OwnerMismatchRejected signs a CounterOp for
+// claimedOwner with a different key, so RecoverWithDomain's EIP-712 path
+// recovers the real signer but finds it doesn't match the Owner the payload
+// claims — the case RecoverWithDomain's owner check exists to catch.
+func TestAnteHandle_EIP712OwnerMismatchRejected(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	domain := testDomain()
+	d := NewEthereumCounterTxDecorator(k, domain)
+
+	signerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	claimedOwner := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	op := eip712.CounterOp{Action: "increment", Amount: 0, Nonce: 0, Owner: claimedOwner}
+	sig, err := eip712.SignTypedData(signerKey, domain, op)
+	if err != nil {
+		t.Fatalf("SignTypedData() error = %v", err)
+	}
+
+	payload := &counter.CounterTxPayload{
+		Action: counter.ActionIncrement,
+		Nonce:  0,
+		Typed:  true,
+		Owner:  claimedOwner,
+		R:      new(big.Int).SetBytes(sig[:32]),
+		S:      new(big.Int).SetBytes(sig[32:64]),
+		V:      new(big.Int).SetUint64(uint64(sig[64])),
+	}
+
+	_, err = d.AnteHandle(ctx, ethereumCounterTx(t, payload), false, noopNext)
+	if err == nil {
+		t.Fatal("AnteHandle() error = nil, want non-nil for EIP-712 owner mismatch")
+	}
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	counter "github.com/pavlenkotm/tutorials-account-balances/examples/go/cosmos-module"
+)
+
+// GetQueryCmd returns the counter module's query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        counter.ModuleName,
+		Short:                      "Querying commands for the counter module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryCounter(),
+		CmdQueryStats(),
+		CmdQueryAllCounters(),
+	)
+
+	return cmd
+}
+
+// CmdQueryCounter queries a single owner's counter value
+func CmdQueryCounter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "counter [owner]",
+		Short: "Query an owner's counter value",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := counter.NewQueryClient(clientCtx)
+			res, err := queryClient.Counter(cmd.Context(), &counter.QueryCounterRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryStats queries a single owner's counter statistics
+func CmdQueryStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [owner]",
+		Short: "Query an owner's counter statistics",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := counter.NewQueryClient(clientCtx)
+			res, err := queryClient.Stats(cmd.Context(), &counter.QueryStatsRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryAllCounters queries every owner's counter, paginated
+func CmdQueryAllCounters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-counters",
+		Short: "Query every owner's counter",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := counter.NewQueryClient(clientCtx)
+			res, err := queryClient.AllCounters(cmd.Context(), &counter.QueryAllCountersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-counters")
+	return cmd
+}
@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	counter "github.com/pavlenkotm/tutorials-account-balances/examples/go/cosmos-module"
+)
+
+const flagPrivKey = "eth-priv-key"
+
+// GetTxCmd returns the counter module's tx commands
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        counter.ModuleName,
+		Short:                      "counter transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdSendEthereumCounterTx(counter.ActionIncrement, "increment", "Increment your counter"),
+		CmdSendEthereumCounterTx(counter.ActionDecrement, "decrement", "Decrement your counter"),
+		CmdSendEthereumCounterTx(counter.ActionIncrementBy, "increment-by [amount]", "Increment your counter by amount"),
+		CmdSendEthereumCounterTx(counter.ActionSet, "set [value]", "Set your counter to value"),
+		CmdSendEthereumCounterTx(counter.ActionReset, "reset", "Reset your counter to 0"),
+	)
+
+	return cmd
+}
+
+// CmdSendEthereumCounterTx builds a cobra command that signs and broadcasts a
+// MsgEthereumCounterTx for action, authenticated by a raw hex Ethereum
+// private key (--eth-priv-key) rather than the Cosmos keyring. This lets a
+// MetaMask-style key holder drive the counter without ever creating a
+// Cosmos key.
+func CmdSendEthereumCounterTx(action counter.CounterAction, use, short string) *cobra.Command {
+	requiresAmount := action == counter.ActionIncrementBy || action == counter.ActionSet
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(boolToArgs(requiresAmount)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			privKeyHex, err := cmd.Flags().GetString(flagPrivKey)
+			if err != nil {
+				return err
+			}
+
+			privKey, err := crypto.HexToECDSA(privKeyHex)
+			if err != nil {
+				return fmt.Errorf("invalid --%s: %w", flagPrivKey, err)
+			}
+
+			var amount uint64
+			if requiresAmount {
+				amount, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid amount: %w", err)
+				}
+			}
+
+			signer := crypto.PubkeyToAddress(privKey.PublicKey)
+			nonce, err := queryNonce(cmd.Context(), clientCtx, signer)
+			if err != nil {
+				return err
+			}
+
+			payload, err := counter.SignCounterTxPayload(privKey, action, amount, nonce)
+			if err != nil {
+				return err
+			}
+
+			data, err := counter.EncodeCounterTxPayload(payload)
+			if err != nil {
+				return err
+			}
+
+			msg := &counter.MsgEthereumCounterTx{Data: data}
+			return broadcastEthereumCounterTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(flagPrivKey, "", "raw hex-encoded Ethereum private key to sign the tx with")
+	_ = cmd.MarkFlagRequired(flagPrivKey)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// broadcastEthereumCounterTx wraps msg in a tx tagged with
+// ExtensionOptionsEthereumCounterTx and broadcasts it. The tx's standard
+// Cosmos signature is left empty since EthereumCounterTxDecorator
+// authenticates the message itself, so txBuilder must be encoded and
+// broadcast directly: tx.BroadcastTx would rebuild and sign an unrelated tx
+// via the Cosmos keyring from scratch, discarding the extension option set
+// below.
+func broadcastEthereumCounterTx(clientCtx client.Context, msg *counter.MsgEthereumCounterTx) error {
+	txf := tx.NewFactoryCLI(clientCtx, nil)
+
+	extAny, err := codectypes.NewAnyWithValue(&counter.ExtensionOptionsEthereumCounterTx{})
+	if err != nil {
+		return err
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return err
+	}
+
+	extBuilder, ok := txBuilder.(interface {
+		SetExtensionOptions(...*codectypes.Any)
+	})
+	if !ok {
+		return fmt.Errorf("tx builder does not support extension options")
+	}
+	extBuilder.SetExtensionOptions(extAny)
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return fmt.Errorf("failed to encode counter tx: %w", err)
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// queryNonce fetches addr's next expected nonce via the counter module's
+// gRPC query service, so the caller never guesses a nonce the ante handler's
+// replay check would reject.
+func queryNonce(ctx context.Context, clientCtx client.Context, addr common.Address) (uint64, error) {
+	res, err := counter.NewQueryClient(clientCtx).Nonce(ctx, &counter.QueryNonceRequest{Owner: addr.Hex()})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query nonce for %s: %w", addr.Hex(), err)
+	}
+	return res.Nonce, nil
+}
+
+func boolToArgs(requiresAmount bool) int {
+	if requiresAmount {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,42 @@
+package counter
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// ModuleCdc is the module's legacy amino codec, used for GetSignBytes-style
+// JSON encoding where a canonical wire format (rather than RLP) is needed.
+var ModuleCdc = codec.NewLegacyAmino()
+
+// RegisterLegacyAminoCodec registers the module's messages on the provided
+// LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgEthereumCounterTx{}, "counter/MsgEthereumCounterTx", nil)
+	cdc.RegisterConcrete(&MsgIncrement{}, "counter/MsgIncrement", nil)
+	cdc.RegisterConcrete(&MsgDecrement{}, "counter/MsgDecrement", nil)
+	cdc.RegisterConcrete(&MsgIncrementBy{}, "counter/MsgIncrementBy", nil)
+	cdc.RegisterConcrete(&MsgSet{}, "counter/MsgSet", nil)
+	cdc.RegisterConcrete(&MsgReset{}, "counter/MsgReset", nil)
+}
+
+// RegisterInterfaces registers the module's Msg implementations against the
+// sdk.Msg interface, and the Msg service descriptor used by the tx client to
+// decode responses.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgEthereumCounterTx{},
+		&MsgIncrement{},
+		&MsgDecrement{},
+		&MsgIncrementBy{},
+		&MsgSet{},
+		&MsgReset{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+func init() {
+	RegisterLegacyAminoCodec(ModuleCdc)
+}
@@ -0,0 +1,63 @@
+package counter
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// RegisterGRPCGatewayRoutes registers the counter module's REST routes on
+// mux, proxying each one to the gRPC query service via clientCtx. This is a
+// hand-rolled stand-in for the *.gw.go a real protoc-gen-grpc-gateway run
+// would produce from query.proto's google.api.http annotations.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	mux.HandlePath("GET", "/counter/v1/counters/{owner}", counterHandler(clientCtx))
+	mux.HandlePath("GET", "/counter/v1/stats/{owner}", statsHandler(clientCtx))
+	mux.HandlePath("GET", "/counter/v1/counters", allCountersHandler(clientCtx))
+}
+
+func counterHandler(clientCtx client.Context) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		queryClient := NewQueryClient(clientCtx)
+		res, err := queryClient.Counter(context.Background(), &QueryCounterRequest{Owner: pathParams["owner"]})
+		if err != nil {
+			writeGRPCGatewayError(w, err)
+			return
+		}
+
+		clientCtx.WithOutput(w).PrintProto(res)
+	}
+}
+
+func statsHandler(clientCtx client.Context) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		queryClient := NewQueryClient(clientCtx)
+		res, err := queryClient.Stats(context.Background(), &QueryStatsRequest{Owner: pathParams["owner"]})
+		if err != nil {
+			writeGRPCGatewayError(w, err)
+			return
+		}
+
+		clientCtx.WithOutput(w).PrintProto(res)
+	}
+}
+
+func allCountersHandler(clientCtx client.Context) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		queryClient := NewQueryClient(clientCtx)
+		res, err := queryClient.AllCounters(context.Background(), &QueryAllCountersRequest{})
+		if err != nil {
+			writeGRPCGatewayError(w, err)
+			return
+		}
+
+		clientCtx.WithOutput(w).PrintProto(res)
+	}
+}
+
+func writeGRPCGatewayError(w http.ResponseWriter, err error) {
+	w.WriteHeader(http.StatusInternalServerError)
+	w.Write([]byte(err.Error()))
+}
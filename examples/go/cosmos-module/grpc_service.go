@@ -0,0 +1,208 @@
+package counter
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// The ServiceDesc values below are what protoc-gen-gogo would emit from
+// query.proto/tx.proto if this module's messages were generated rather than
+// hand-written; they're kept here so QueryServer/MsgServer can be registered
+// with a Configurator exactly as a generated module's would be.
+//
+// There is no query.proto/tx.proto and no protoc-gen-gogo pipeline anywhere
+// in this module (this example has no buf/protoc toolchain at all), so
+// these ServiceDescs, and the JSON-backed Marshal/Unmarshal/Size on every
+// Query*/Msg* type (see proto_compat.go), are the full extent of the
+// "gRPC/REST query server and Msg service" this module provides: they let
+// QueryClient/NewMsgServerImpl and a Configurator talk to each other, not a
+// real protoc-gen-gogo client or an `appd query counter ...` CLI built
+// against generated stubs. Treat that as this module's deliberately scoped
+// stand-in for codegen, not as an oversight.
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "counter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Counter",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(QueryCounterRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(QueryServer).Counter(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Query/Counter"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(QueryServer).Counter(ctx, req.(*QueryCounterRequest))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "Stats",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(QueryStatsRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(QueryServer).Stats(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Query/Stats"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "Nonce",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(QueryNonceRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(QueryServer).Nonce(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Query/Nonce"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(QueryServer).Nonce(ctx, req.(*QueryNonceRequest))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "AllCounters",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(QueryAllCountersRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(QueryServer).AllCounters(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Query/AllCounters"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(QueryServer).AllCounters(ctx, req.(*QueryAllCountersRequest))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+	},
+	Metadata: "counter/query.proto",
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "counter.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Increment",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(MsgIncrement)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(MsgServer).Increment(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Msg/Increment"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(MsgServer).Increment(ctx, req.(*MsgIncrement))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "Decrement",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(MsgDecrement)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(MsgServer).Decrement(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Msg/Decrement"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(MsgServer).Decrement(ctx, req.(*MsgDecrement))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "IncrementBy",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(MsgIncrementBy)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(MsgServer).IncrementBy(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Msg/IncrementBy"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(MsgServer).IncrementBy(ctx, req.(*MsgIncrementBy))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "Set",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(MsgSet)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(MsgServer).Set(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Msg/Set"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(MsgServer).Set(ctx, req.(*MsgSet))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "Reset",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(MsgReset)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(MsgServer).Reset(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Msg/Reset"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(MsgServer).Reset(ctx, req.(*MsgReset))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "EthereumCounterTx",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(MsgEthereumCounterTx)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(MsgServer).EthereumCounterTx(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/counter.v1.Msg/EthereumCounterTx"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(MsgServer).EthereumCounterTx(ctx, req.(*MsgEthereumCounterTx))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+	},
+	Metadata: "counter/tx.proto",
+}
@@ -0,0 +1,184 @@
+package counter
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// historyKey builds the per-event store key
+// HistoryKey || bigendian(height) || bigendian(seq)
+func historyKey(height int64, seq uint64) []byte {
+	key := make([]byte, 0, len(HistoryKey)+8+8)
+	key = append(key, HistoryKey...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, sdk.Uint64ToBigEndian(seq)...)
+	return key
+}
+
+// nextHistorySeq returns the next monotonic sequence number for a HistoryKey
+// entry and advances the counter in the store.
+func (k Keeper) nextHistorySeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(HistorySeqKey)
+
+	var seq uint64
+	if bz != nil {
+		seq = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(HistorySeqKey, sdk.Uint64ToBigEndian(seq+1))
+	return seq
+}
+
+// recordEvent appends an Event describing a counter mutation and folds it
+// into the rolling Aggregates. isNewOwner should reflect whether addr had a
+// stored counter entry before this mutation, so UniqueOwners only counts
+// each owner once.
+func (k Keeper) recordEvent(ctx sdk.Context, action CounterAction, addr common.Address, delta int64, newValue uint64, isNewOwner bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	event := Event{
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+		Delta:  delta,
+		Action: actionEventName(action),
+		Owner:  addr.Hex(),
+	}
+
+	bz, err := json.Marshal(event)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(historyKey(event.Height, k.nextHistorySeq(ctx)), bz)
+
+	k.updateAggregates(ctx, action, delta, newValue, isNewOwner)
+}
+
+// actionEventName renders action the same way actionName does for EIP-712,
+// so an Event's Action string lines up with what a wallet would show.
+func actionEventName(action CounterAction) string {
+	name, err := actionName(action)
+	if err != nil {
+		return "unknown"
+	}
+	return name
+}
+
+// GetHistory returns every Event recorded for heights in [from, to]
+func (k Keeper) GetHistory(ctx sdk.Context, from, to int64) []Event {
+	store := ctx.KVStore(k.storeKey)
+
+	start := append(append([]byte{}, HistoryKey...), sdk.Uint64ToBigEndian(uint64(from))...)
+	end := append(append([]byte{}, HistoryKey...), sdk.Uint64ToBigEndian(uint64(to+1))...)
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	var events []Event
+	for ; iterator.Valid(); iterator.Next() {
+		var event Event
+		if err := json.Unmarshal(iterator.Value(), &event); err != nil {
+			panic(err)
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// PruneHistoryBefore deletes every History entry recorded at a height below
+// height. It's driven from BeginBlocker using Params.HistoryWindowBlocks.
+func (k Keeper) PruneHistoryBefore(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	end := append(append([]byte{}, HistoryKey...), sdk.Uint64ToBigEndian(uint64(height))...)
+	iterator := store.Iterator(HistoryKey, end)
+	defer iterator.Close()
+
+	// Collect keys before deleting: mutating the store under an active
+	// iterator isn't safe for every backing store implementation.
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAggregates returns the module's rolling, all-time counter statistics
+func (k Keeper) GetAggregates(ctx sdk.Context) Aggregates {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(AggregatesKey)
+	if bz == nil {
+		return Aggregates{}
+	}
+
+	var agg Aggregates
+	if err := json.Unmarshal(bz, &agg); err != nil {
+		panic(err)
+	}
+	return agg
+}
+
+func (k Keeper) setAggregates(ctx sdk.Context, agg Aggregates) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(agg)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(AggregatesKey, bz)
+}
+
+// updateAggregates folds one counter mutation into the stored Aggregates
+func (k Keeper) updateAggregates(ctx sdk.Context, action CounterAction, delta int64, newValue uint64, isNewOwner bool) {
+	agg := k.GetAggregates(ctx)
+
+	switch action {
+	case ActionIncrement, ActionIncrementBy:
+		agg.TotalIncrements++
+		if delta > 0 {
+			agg.SumOfAmounts += uint64(delta)
+		}
+	case ActionDecrement:
+		agg.TotalDecrements++
+	}
+
+	if newValue > agg.MaxValueSeen {
+		agg.MaxValueSeen = newValue
+	}
+	if isNewOwner {
+		agg.UniqueOwners++
+	}
+
+	k.setAggregates(ctx, agg)
+}
+
+// GetParams returns the module's current Params, or DefaultParams if none
+// have been set
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return DefaultParams()
+	}
+
+	var params Params
+	if err := json.Unmarshal(bz, &params); err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams sets the module's Params
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(params)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(ParamsKey, bz)
+}
@@ -0,0 +1,69 @@
+package counter_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	counter "github.com/pavlenkotm/tutorials-account-balances/examples/go/cosmos-module"
+)
+
+func TestHistoryAndAggregates(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	ctx = ctx.WithBlockHeight(10)
+	if _, err := k.IncrementFor(ctx, addr); err != nil {
+		t.Fatalf("IncrementFor() error = %v", err)
+	}
+
+	ctx = ctx.WithBlockHeight(11)
+	if _, err := k.IncrementByFor(ctx, addr, 5); err != nil {
+		t.Fatalf("IncrementByFor() error = %v", err)
+	}
+
+	events := k.GetHistory(ctx, 10, 11)
+	if len(events) != 2 {
+		t.Fatalf("GetHistory(10, 11) returned %d events, want 2", len(events))
+	}
+	if events[0].Action != "increment" || events[1].Action != "increment_by" {
+		t.Fatalf("unexpected event actions: %+v", events)
+	}
+
+	agg := k.GetAggregates(ctx)
+	if agg.TotalIncrements != 2 {
+		t.Fatalf("Aggregates.TotalIncrements = %d, want 2", agg.TotalIncrements)
+	}
+	if agg.SumOfAmounts != 6 {
+		t.Fatalf("Aggregates.SumOfAmounts = %d, want 6", agg.SumOfAmounts)
+	}
+	if agg.MaxValueSeen != 6 {
+		t.Fatalf("Aggregates.MaxValueSeen = %d, want 6", agg.MaxValueSeen)
+	}
+	if agg.UniqueOwners != 1 {
+		t.Fatalf("Aggregates.UniqueOwners = %d, want 1", agg.UniqueOwners)
+	}
+}
+
+func TestBeginBlockerPrunesOldHistory(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	addr := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	k.SetParams(ctx, counter.Params{HistoryWindowBlocks: 5})
+
+	ctx = ctx.WithBlockHeight(1)
+	if _, err := k.IncrementFor(ctx, addr); err != nil {
+		t.Fatalf("IncrementFor() error = %v", err)
+	}
+
+	ctx = ctx.WithBlockHeight(100)
+	counter.BeginBlocker(ctx, k)
+
+	if events := k.GetHistory(ctx, 0, 100); len(events) != 0 {
+		t.Fatalf("GetHistory() after pruning returned %d events, want 0", len(events))
+	}
+
+	agg := k.GetAggregates(ctx)
+	if agg.TotalIncrements != 1 {
+		t.Fatalf("pruning must not touch Aggregates: TotalIncrements = %d, want 1", agg.TotalIncrements)
+	}
+}
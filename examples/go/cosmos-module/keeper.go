@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -31,10 +32,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
 }
 
-// GetCounter gets the counter value from the store
-func (k Keeper) GetCounter(ctx sdk.Context) uint64 {
+// counterStoreKey builds the per-owner store key CounterKey || ethAddr[20]
+func counterStoreKey(addr common.Address) []byte {
+	return append(CounterKey, addr.Bytes()...)
+}
+
+// GetCounterFor gets the counter value for a given owner address
+func (k Keeper) GetCounterFor(ctx sdk.Context, addr common.Address) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(CounterKey)
+	bz := store.Get(counterStoreKey(addr))
 	if bz == nil {
 		return 0
 	}
@@ -44,115 +50,182 @@ func (k Keeper) GetCounter(ctx sdk.Context) uint64 {
 	return counter
 }
 
-// SetCounter sets the counter value in the store
-func (k Keeper) SetCounter(ctx sdk.Context, counter uint64) {
+// SetCounterFor sets the counter value for a given owner address
+func (k Keeper) SetCounterFor(ctx sdk.Context, addr common.Address, counter uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&counter)
-	store.Set(CounterKey, bz)
+	store.Set(counterStoreKey(addr), bz)
+}
+
+// ownerExists reports whether addr already has a stored counter entry, so
+// callers can tell a first-time owner from one whose counter happens to be 0
+func (k Keeper) ownerExists(ctx sdk.Context, addr common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(counterStoreKey(addr))
 }
 
-// Increment increments the counter by 1
-func (k Keeper) Increment(ctx sdk.Context) (uint64, error) {
-	counter := k.GetCounter(ctx)
+// IncrementFor increments addr's counter by 1
+func (k Keeper) IncrementFor(ctx sdk.Context, addr common.Address) (uint64, error) {
+	isNewOwner := !k.ownerExists(ctx, addr)
+
+	counter := k.GetCounterFor(ctx, addr)
 	counter++
-	k.SetCounter(ctx, counter)
+	k.SetCounterFor(ctx, addr, counter)
 
-	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeIncrement,
+			sdk.NewAttribute(AttributeKeyOwner, addr.Hex()),
 			sdk.NewAttribute(AttributeKeyCounter, fmt.Sprintf("%d", counter)),
 			sdk.NewAttribute(AttributeKeyAction, "increment"),
 		),
 	)
 
-	k.Logger(ctx).Info("Counter incremented", "new_value", counter)
+	k.recordEvent(ctx, ActionIncrement, addr, 1, counter, isNewOwner)
+	k.Logger(ctx).Info("Counter incremented", "owner", addr.Hex(), "new_value", counter)
 	return counter, nil
 }
 
-// Decrement decrements the counter by 1
-func (k Keeper) Decrement(ctx sdk.Context) (uint64, error) {
-	counter := k.GetCounter(ctx)
+// DecrementFor decrements addr's counter by 1
+func (k Keeper) DecrementFor(ctx sdk.Context, addr common.Address) (uint64, error) {
+	isNewOwner := !k.ownerExists(ctx, addr)
+
+	counter := k.GetCounterFor(ctx, addr)
 	if counter == 0 {
-		return 0, fmt.Errorf("counter underflow: cannot decrement below 0")
+		return 0, fmt.Errorf("counter underflow: cannot decrement below 0 for owner %s", addr.Hex())
 	}
 
 	counter--
-	k.SetCounter(ctx, counter)
+	k.SetCounterFor(ctx, addr, counter)
 
-	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeDecrement,
+			sdk.NewAttribute(AttributeKeyOwner, addr.Hex()),
 			sdk.NewAttribute(AttributeKeyCounter, fmt.Sprintf("%d", counter)),
 			sdk.NewAttribute(AttributeKeyAction, "decrement"),
 		),
 	)
 
-	k.Logger(ctx).Info("Counter decremented", "new_value", counter)
+	k.recordEvent(ctx, ActionDecrement, addr, -1, counter, isNewOwner)
+	k.Logger(ctx).Info("Counter decremented", "owner", addr.Hex(), "new_value", counter)
 	return counter, nil
 }
 
-// IncrementBy increments the counter by a specific amount
-func (k Keeper) IncrementBy(ctx sdk.Context, amount uint64) (uint64, error) {
+// IncrementByFor increments addr's counter by a specific amount
+func (k Keeper) IncrementByFor(ctx sdk.Context, addr common.Address, amount uint64) (uint64, error) {
 	if amount == 0 {
-		return k.GetCounter(ctx), fmt.Errorf("amount must be greater than 0")
+		return k.GetCounterFor(ctx, addr), fmt.Errorf("amount must be greater than 0")
 	}
 
-	counter := k.GetCounter(ctx)
+	isNewOwner := !k.ownerExists(ctx, addr)
+
+	counter := k.GetCounterFor(ctx, addr)
 	counter += amount
-	k.SetCounter(ctx, counter)
+	k.SetCounterFor(ctx, addr, counter)
 
-	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeIncrementBy,
+			sdk.NewAttribute(AttributeKeyOwner, addr.Hex()),
 			sdk.NewAttribute(AttributeKeyCounter, fmt.Sprintf("%d", counter)),
 			sdk.NewAttribute(AttributeKeyAmount, fmt.Sprintf("%d", amount)),
 		),
 	)
 
-	k.Logger(ctx).Info("Counter incremented by amount", "amount", amount, "new_value", counter)
+	k.recordEvent(ctx, ActionIncrementBy, addr, int64(amount), counter, isNewOwner)
+	k.Logger(ctx).Info("Counter incremented by amount", "owner", addr.Hex(), "amount", amount, "new_value", counter)
 	return counter, nil
 }
 
-// Reset resets the counter to 0
-func (k Keeper) Reset(ctx sdk.Context) error {
-	k.SetCounter(ctx, 0)
+// ResetFor resets addr's counter to 0
+func (k Keeper) ResetFor(ctx sdk.Context, addr common.Address) error {
+	isNewOwner := !k.ownerExists(ctx, addr)
+	oldValue := k.GetCounterFor(ctx, addr)
+
+	k.SetCounterFor(ctx, addr, 0)
 
-	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeReset,
+			sdk.NewAttribute(AttributeKeyOwner, addr.Hex()),
 			sdk.NewAttribute(AttributeKeyCounter, "0"),
 		),
 	)
 
-	k.Logger(ctx).Info("Counter reset to 0")
+	k.recordEvent(ctx, ActionReset, addr, -int64(oldValue), 0, isNewOwner)
+	k.Logger(ctx).Info("Counter reset to 0", "owner", addr.Hex())
 	return nil
 }
 
-// SetCounterValue sets the counter to a specific value
-func (k Keeper) SetCounterValue(ctx sdk.Context, value uint64) error {
-	k.SetCounter(ctx, value)
+// SetCounterValueFor sets addr's counter to a specific value
+func (k Keeper) SetCounterValueFor(ctx sdk.Context, addr common.Address, value uint64) error {
+	isNewOwner := !k.ownerExists(ctx, addr)
+	oldValue := k.GetCounterFor(ctx, addr)
+
+	k.SetCounterFor(ctx, addr, value)
 
-	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeSet,
+			sdk.NewAttribute(AttributeKeyOwner, addr.Hex()),
 			sdk.NewAttribute(AttributeKeyCounter, fmt.Sprintf("%d", value)),
 		),
 	)
 
-	k.Logger(ctx).Info("Counter set to value", "value", value)
+	k.recordEvent(ctx, ActionSet, addr, int64(value)-int64(oldValue), value, isNewOwner)
+	k.Logger(ctx).Info("Counter set to value", "owner", addr.Hex(), "value", value)
 	return nil
 }
 
-// GetStats returns comprehensive statistics
-func (k Keeper) GetStats(ctx sdk.Context) Stats {
+// IterateCounters walks every stored owner/counter pair, stopping early if cb
+// returns false
+func (k Keeper) IterateCounters(ctx sdk.Context, cb func(addr common.Address, counter uint64) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, CounterKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := common.BytesToAddress(iterator.Key()[len(CounterKey):])
+
+		var counter uint64
+		k.cdc.MustUnmarshal(iterator.Value(), &counter)
+
+		if !cb(addr, counter) {
+			break
+		}
+	}
+}
+
+// GetStats returns comprehensive statistics for a single owner
+func (k Keeper) GetStats(ctx sdk.Context, addr common.Address) Stats {
 	return Stats{
-		Counter:     k.GetCounter(ctx),
+		Owner:       addr.Hex(),
+		Counter:     k.GetCounterFor(ctx, addr),
 		BlockHeight: ctx.BlockHeight(),
 		BlockTime:   ctx.BlockTime(),
 	}
 }
+
+// nonceStoreKey builds the per-owner store key NonceKey || ethAddr[20]
+func nonceStoreKey(addr common.Address) []byte {
+	return append(NonceKey, addr.Bytes()...)
+}
+
+// GetNonce returns the next expected nonce for addr's Ethereum-signed
+// counter txs, defaulting to 0 for an address that has never transacted
+func (k Keeper) GetNonce(ctx sdk.Context, addr common.Address) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nonceStoreKey(addr))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNonce sets the next expected nonce for addr
+func (k Keeper) SetNonce(ctx sdk.Context, addr common.Address, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nonceStoreKey(addr), sdk.Uint64ToBigEndian(nonce))
+}
@@ -0,0 +1,27 @@
+package counter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MigrateToPerOwnerCounters migrates the legacy layout, where CounterKey held
+// a single global uint64 directly, to the per-owner layout. The legacy value,
+// if present, is assigned to genesisOwner so history isn't lost, and the
+// legacy key is deleted so it can't be mistaken for a per-owner entry.
+func MigrateToPerOwnerCounters(ctx sdk.Context, k Keeper, genesisOwner common.Address) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(CounterKey)
+	if bz == nil {
+		return nil
+	}
+
+	var legacy uint64
+	k.cdc.MustUnmarshal(bz, &legacy)
+	store.Delete(CounterKey)
+
+	k.SetCounterFor(ctx, genesisOwner, legacy)
+	k.Logger(ctx).Info("migrated legacy counter to per-owner layout", "genesis_owner", genesisOwner.Hex(), "value", legacy)
+	return nil
+}
@@ -0,0 +1,186 @@
+package counter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/pavlenkotm/tutorials-account-balances/examples/go/cosmos-module/client/cli"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic implements the sdk.AppModuleBasic interface for the counter
+// module, i.e. everything about it that doesn't need a Keeper.
+type AppModuleBasic struct{}
+
+// Name returns the module's name
+func (AppModuleBasic) Name() string { return ModuleName }
+
+// RegisterLegacyAminoCodec registers the module's types on the LegacyAmino codec
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the module's interface types
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns the module's default genesis state, marshaled as JSON
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	bz, err := json.Marshal(DefaultGenesisState())
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal default %s genesis state: %v", ModuleName, err))
+	}
+	return bz
+}
+
+// ValidateGenesis performs basic genesis state validation
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState GenesisState
+	if err := json.Unmarshal(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	seen := make(map[string]bool, len(genState.Counters))
+	for _, gc := range genState.Counters {
+		if seen[gc.Owner] {
+			return fmt.Errorf("duplicate owner %s in %s genesis state", gc.Owner, ModuleName)
+		}
+		seen[gc.Owner] = true
+	}
+
+	return nil
+}
+
+// RegisterGRPCGatewayRoutes registers the module's REST routes
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	RegisterGRPCGatewayRoutes(clientCtx, mux)
+}
+
+// GetTxCmd returns the module's root tx command
+func (AppModuleBasic) GetTxCmd() *cobra.Command { return cli.GetTxCmd() }
+
+// GetQueryCmd returns the module's root query command
+func (AppModuleBasic) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
+
+// AppModule implements the sdk.AppModule interface for the counter module
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+
+	// legacyGenesisOwner is the owner MigrateToPerOwnerCounters assigns the
+	// pre-migration global counter value to, during the v1->v2 upgrade
+	// registered in RegisterServices below.
+	legacyGenesisOwner common.Address
+}
+
+// NewAppModule creates a new AppModule for the counter module. A chain that
+// is upgrading from the legacy single-counter layout and needs the migrated
+// value attributed to a specific address should use
+// NewAppModuleWithLegacyOwner instead.
+func NewAppModule(k Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         k,
+	}
+}
+
+// NewAppModuleWithLegacyOwner is NewAppModule, but assigns the legacy global
+// counter value (if any) to legacyOwner when MigrateToPerOwnerCounters runs.
+func NewAppModuleWithLegacyOwner(k Keeper, legacyOwner common.Address) AppModule {
+	return AppModule{
+		AppModuleBasic:     AppModuleBasic{},
+		keeper:             k,
+		legacyGenesisOwner: legacyOwner,
+	}
+}
+
+// RegisterServices registers the module's Msg and Query services with cfg,
+// and the v1->v2 migration that moves the legacy single-key counter layout
+// to the per-owner layout (see MigrateToPerOwnerCounters).
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	cfg.MsgServer().RegisterService(&_Msg_serviceDesc, NewMsgServerImpl(am.keeper))
+	cfg.QueryServer().RegisterService(&_Query_serviceDesc, NewQueryServer(am.keeper))
+
+	if err := cfg.RegisterMigration(ModuleName, 1, func(ctx sdk.Context) error {
+		return MigrateToPerOwnerCounters(ctx, am.keeper, am.legacyGenesisOwner)
+	}); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration from version 1 to 2: %v", ModuleName, err))
+	}
+}
+
+// RegisterInvariants registers the module's invariants; the counter module
+// has none
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+// Route returns the module's legacy message route; the counter module
+// routes Msgs exclusively through the gRPC Msg service registered in
+// RegisterServices, so there is no legacy handler to route to.
+func (AppModule) Route() sdk.Route { return sdk.Route{} }
+
+// QuerierRoute returns the module's legacy query route; the counter module
+// only exposes queries through the gRPC Query service registered in
+// RegisterServices, so there is no legacy querier route.
+func (AppModule) QuerierRoute() string { return "" }
+
+// LegacyQuerierHandler returns the module's legacy query handler; the
+// counter module has none.
+func (AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier { return nil }
+
+// InitGenesis initializes the module's state from its genesis state. cdc is
+// unused: GenesisState is a plain struct with json tags, not a proto.Message,
+// so it's decoded with encoding/json like DefaultGenesis/ValidateGenesis
+// above rather than through the codec.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState GenesisState
+	if err := json.Unmarshal(gs, &genState); err != nil {
+		panic(err)
+	}
+	InitGenesis(ctx, am.keeper, genState)
+	return nil
+}
+
+// ExportGenesis returns the module's exported genesis state as JSON
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := ExportGenesis(ctx, am.keeper)
+	bz, err := json.Marshal(&genState)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ConsensusVersion is a sequence number for state-breaking change of the
+// module. It should be incremented on each consensus-breaking change
+// introduced by the module. To avoid wrong/empty versions, the initial
+// version should be set to 1.
+//
+// Version 2 introduced the per-owner counter layout; see
+// MigrateToPerOwnerCounters and the migration registered in RegisterServices.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// BeginBlock executes all ABCI BeginBlock logic respective to the counter
+// module: pruning History entries older than Params.HistoryWindowBlocks
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
+}
+
+// EndBlock executes all ABCI EndBlock logic respective to the counter module
+// and returns no validator updates
+func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return nil
+}
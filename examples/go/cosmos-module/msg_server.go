@@ -0,0 +1,279 @@
+package counter
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Standard Msg type names, used by Type() below
+const (
+	TypeMsgIncrement   = "increment"
+	TypeMsgDecrement   = "decrement"
+	TypeMsgIncrementBy = "increment_by"
+	TypeMsgSet         = "set"
+	TypeMsgReset       = "reset"
+)
+
+// validateOwner requires owner to be a well-formed hex Ethereum address
+func validateOwner(owner string) error {
+	if !common.IsHexAddress(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", owner)
+	}
+	return nil
+}
+
+// signersFor treats owner's 20 raw Ethereum address bytes as the equivalent
+// sdk.AccAddress, the same convention MsgEthereumCounterTx.GetSigners uses:
+// these Msgs are meant to be submitted with a Cosmos key whose address bytes
+// are the owner's Ethereum address.
+func signersFor(owner string) []sdk.AccAddress {
+	if !common.IsHexAddress(owner) {
+		return nil
+	}
+	return []sdk.AccAddress{sdk.AccAddress(common.HexToAddress(owner).Bytes())}
+}
+
+// MsgIncrement requests that owner's counter be incremented by 1
+type MsgIncrement struct {
+	Owner string `json:"owner"`
+}
+
+func (*MsgIncrement) Reset()                      {}
+func (m *MsgIncrement) String() string            { return fmt.Sprintf("MsgIncrement{%s}", m.Owner) }
+func (*MsgIncrement) ProtoMessage()               {}
+func (m *MsgIncrement) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgIncrement) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgIncrement) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+func (msg MsgIncrement) Route() string            { return RouterKey }
+func (msg MsgIncrement) Type() string             { return TypeMsgIncrement }
+func (msg MsgIncrement) ValidateBasic() error     { return validateOwner(msg.Owner) }
+func (msg MsgIncrement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgIncrement) GetSigners() []sdk.AccAddress { return signersFor(msg.Owner) }
+
+// MsgIncrementResponse is the response type for MsgIncrement
+type MsgIncrementResponse struct {
+	NewValue uint64 `json:"new_value"`
+}
+
+func (*MsgIncrementResponse) Reset()                      {}
+func (*MsgIncrementResponse) String() string              { return "MsgIncrementResponse" }
+func (*MsgIncrementResponse) ProtoMessage()               {}
+func (m *MsgIncrementResponse) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgIncrementResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgIncrementResponse) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+
+// MsgDecrement requests that owner's counter be decremented by 1
+type MsgDecrement struct {
+	Owner string `json:"owner"`
+}
+
+func (*MsgDecrement) Reset()                      {}
+func (m *MsgDecrement) String() string            { return fmt.Sprintf("MsgDecrement{%s}", m.Owner) }
+func (*MsgDecrement) ProtoMessage()               {}
+func (m *MsgDecrement) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgDecrement) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgDecrement) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+func (msg MsgDecrement) Route() string            { return RouterKey }
+func (msg MsgDecrement) Type() string             { return TypeMsgDecrement }
+func (msg MsgDecrement) ValidateBasic() error     { return validateOwner(msg.Owner) }
+func (msg MsgDecrement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgDecrement) GetSigners() []sdk.AccAddress { return signersFor(msg.Owner) }
+
+// MsgDecrementResponse is the response type for MsgDecrement
+type MsgDecrementResponse struct {
+	NewValue uint64 `json:"new_value"`
+}
+
+func (*MsgDecrementResponse) Reset()                      {}
+func (*MsgDecrementResponse) String() string              { return "MsgDecrementResponse" }
+func (*MsgDecrementResponse) ProtoMessage()               {}
+func (m *MsgDecrementResponse) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgDecrementResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgDecrementResponse) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+
+// MsgIncrementBy requests that owner's counter be incremented by Amount
+type MsgIncrementBy struct {
+	Owner  string `json:"owner"`
+	Amount uint64 `json:"amount"`
+}
+
+func (*MsgIncrementBy) Reset() {}
+func (m *MsgIncrementBy) String() string {
+	return fmt.Sprintf("MsgIncrementBy{%s,%d}", m.Owner, m.Amount)
+}
+func (*MsgIncrementBy) ProtoMessage()               {}
+func (m *MsgIncrementBy) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgIncrementBy) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgIncrementBy) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+func (msg MsgIncrementBy) Route() string            { return RouterKey }
+func (msg MsgIncrementBy) Type() string             { return TypeMsgIncrementBy }
+func (msg MsgIncrementBy) ValidateBasic() error {
+	if err := validateOwner(msg.Owner); err != nil {
+		return err
+	}
+	if msg.Amount == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than 0")
+	}
+	return nil
+}
+func (msg MsgIncrementBy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgIncrementBy) GetSigners() []sdk.AccAddress { return signersFor(msg.Owner) }
+
+// MsgIncrementByResponse is the response type for MsgIncrementBy
+type MsgIncrementByResponse struct {
+	NewValue uint64 `json:"new_value"`
+}
+
+func (*MsgIncrementByResponse) Reset()                      {}
+func (*MsgIncrementByResponse) String() string              { return "MsgIncrementByResponse" }
+func (*MsgIncrementByResponse) ProtoMessage()               {}
+func (m *MsgIncrementByResponse) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgIncrementByResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgIncrementByResponse) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+
+// MsgSet requests that owner's counter be set to Value
+type MsgSet struct {
+	Owner string `json:"owner"`
+	Value uint64 `json:"value"`
+}
+
+func (*MsgSet) Reset()                      {}
+func (m *MsgSet) String() string            { return fmt.Sprintf("MsgSet{%s,%d}", m.Owner, m.Value) }
+func (*MsgSet) ProtoMessage()               {}
+func (m *MsgSet) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgSet) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgSet) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+func (msg MsgSet) Route() string            { return RouterKey }
+func (msg MsgSet) Type() string             { return TypeMsgSet }
+func (msg MsgSet) ValidateBasic() error     { return validateOwner(msg.Owner) }
+func (msg MsgSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSet) GetSigners() []sdk.AccAddress { return signersFor(msg.Owner) }
+
+// MsgSetResponse is the response type for MsgSet
+type MsgSetResponse struct {
+	NewValue uint64 `json:"new_value"`
+}
+
+func (*MsgSetResponse) Reset()                      {}
+func (*MsgSetResponse) String() string              { return "MsgSetResponse" }
+func (*MsgSetResponse) ProtoMessage()               {}
+func (m *MsgSetResponse) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgSetResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgSetResponse) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+
+// MsgReset requests that owner's counter be reset to 0
+type MsgReset struct {
+	Owner string `json:"owner"`
+}
+
+func (*MsgReset) Reset()                      {}
+func (m *MsgReset) String() string            { return fmt.Sprintf("MsgReset{%s}", m.Owner) }
+func (*MsgReset) ProtoMessage()               {}
+func (m *MsgReset) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgReset) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgReset) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+func (msg MsgReset) Route() string            { return RouterKey }
+func (msg MsgReset) Type() string             { return TypeMsgReset }
+func (msg MsgReset) ValidateBasic() error     { return validateOwner(msg.Owner) }
+func (msg MsgReset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgReset) GetSigners() []sdk.AccAddress { return signersFor(msg.Owner) }
+
+// MsgResetResponse is the response type for MsgReset
+type MsgResetResponse struct {
+	NewValue uint64 `json:"new_value"`
+}
+
+func (*MsgResetResponse) Reset()                      {}
+func (*MsgResetResponse) String() string              { return "MsgResetResponse" }
+func (*MsgResetResponse) ProtoMessage()               {}
+func (m *MsgResetResponse) Marshal() ([]byte, error)  { return marshalJSON(m) }
+func (m *MsgResetResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+func (m *MsgResetResponse) Size() int                 { bz, _ := m.Marshal(); return len(bz) }
+
+// MsgServer is the gRPC Msg service for the counter module: the non-Ethereum-
+// signed counterpart to MsgEthereumCounterTx, for callers who'd rather sign
+// with a standard Cosmos key than RLP-encode a raw Ethereum payload.
+type MsgServer interface {
+	Increment(context.Context, *MsgIncrement) (*MsgIncrementResponse, error)
+	Decrement(context.Context, *MsgDecrement) (*MsgDecrementResponse, error)
+	IncrementBy(context.Context, *MsgIncrementBy) (*MsgIncrementByResponse, error)
+	Set(context.Context, *MsgSet) (*MsgSetResponse, error)
+	Reset(context.Context, *MsgReset) (*MsgResetResponse, error)
+	EthereumCounterTx(context.Context, *MsgEthereumCounterTx) (*MsgEthereumCounterTxResponse, error)
+}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of MsgServer backed by k
+func NewMsgServerImpl(k Keeper) MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+func (m msgServer) Increment(goCtx context.Context, msg *MsgIncrement) (*MsgIncrementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	newValue, err := m.Keeper.IncrementFor(ctx, common.HexToAddress(msg.Owner))
+	if err != nil {
+		return nil, err
+	}
+	return &MsgIncrementResponse{NewValue: newValue}, nil
+}
+
+func (m msgServer) Decrement(goCtx context.Context, msg *MsgDecrement) (*MsgDecrementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	newValue, err := m.Keeper.DecrementFor(ctx, common.HexToAddress(msg.Owner))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &MsgDecrementResponse{NewValue: newValue}, nil
+}
+
+func (m msgServer) IncrementBy(goCtx context.Context, msg *MsgIncrementBy) (*MsgIncrementByResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	newValue, err := m.Keeper.IncrementByFor(ctx, common.HexToAddress(msg.Owner), msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &MsgIncrementByResponse{NewValue: newValue}, nil
+}
+
+func (m msgServer) Set(goCtx context.Context, msg *MsgSet) (*MsgSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetCounterValueFor(ctx, common.HexToAddress(msg.Owner), msg.Value); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &MsgSetResponse{NewValue: msg.Value}, nil
+}
+
+func (m msgServer) Reset(goCtx context.Context, msg *MsgReset) (*MsgResetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.ResetFor(ctx, common.HexToAddress(msg.Owner)); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &MsgResetResponse{NewValue: 0}, nil
+}
+
+// EthereumCounterTx is a no-op: EthereumCounterTxDecorator (ante/ante.go)
+// authenticates msg's Ethereum signature, checks its nonce, and applies its
+// action to the keeper before the Msg service ever sees it. This handler
+// exists only so MsgEthereumCounterTx has a registered route — without one,
+// baseapp.runTx fails DeliverTx with "can't route message" after the ante
+// handler's state changes have already been committed to the real store.
+func (m msgServer) EthereumCounterTx(context.Context, *MsgEthereumCounterTx) (*MsgEthereumCounterTxResponse, error) {
+	return &MsgEthereumCounterTxResponse{}, nil
+}
@@ -0,0 +1,23 @@
+package counter
+
+import "encoding/json"
+
+// This module has no query.proto/tx.proto and no protoc-gen-gogo pipeline
+// (see grpc_service.go), so its Query/Msg request and response types only
+// had the bare proto.Message marker methods (Reset/String/ProtoMessage).
+// That's not enough for a real gRPC codec: cosmos-sdk's query router
+// marshals with gogoproto, which requires a Marshaler/Unmarshaler/Sized
+// implementation (or full reflection-based proto tags, which these structs
+// don't have either). marshalJSON/unmarshalJSON back Marshal/Unmarshal/Size
+// on every such type with plain JSON, so the hand-rolled ServiceDescs in
+// grpc_service.go can actually round-trip a request over
+// grpc.ClientConnInterface.Invoke. This is not wire-compatible with a real
+// protoc-gen-gogo client; it only makes this module's own QueryClient and
+// server implementation interoperate with each other.
+func marshalJSON(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func unmarshalJSON(bz []byte, v interface{}) error {
+	return json.Unmarshal(bz, v)
+}
@@ -0,0 +1,279 @@
+package counter
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QueryCounterRequest is the request type for the Query.Counter RPC
+type QueryCounterRequest struct {
+	// Owner is the hex-encoded Ethereum address whose counter is requested
+	Owner string `json:"owner"`
+}
+
+// Reset implements proto.Message
+func (*QueryCounterRequest) Reset() {}
+
+// String implements proto.Message
+func (m *QueryCounterRequest) String() string { return "QueryCounterRequest{" + m.Owner + "}" }
+
+// ProtoMessage implements proto.Message
+func (*QueryCounterRequest) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryCounterRequest) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryCounterRequest) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryCounterRequest) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// Reset implements proto.Message
+func (*QueryCounterResponse) Reset() {}
+
+// String implements proto.Message
+func (*QueryCounterResponse) String() string { return "QueryCounterResponse" }
+
+// ProtoMessage implements proto.Message
+func (*QueryCounterResponse) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryCounterResponse) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryCounterResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryCounterResponse) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// QueryStatsRequest is the request type for the Query.Stats RPC
+type QueryStatsRequest struct {
+	// Owner is the hex-encoded Ethereum address whose stats are requested
+	Owner string `json:"owner"`
+}
+
+// Reset implements proto.Message
+func (*QueryStatsRequest) Reset() {}
+
+// String implements proto.Message
+func (m *QueryStatsRequest) String() string { return "QueryStatsRequest{" + m.Owner + "}" }
+
+// ProtoMessage implements proto.Message
+func (*QueryStatsRequest) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryStatsRequest) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryStatsRequest) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryStatsRequest) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// Reset implements proto.Message
+func (*QueryStatsResponse) Reset() {}
+
+// String implements proto.Message
+func (*QueryStatsResponse) String() string { return "QueryStatsResponse" }
+
+// ProtoMessage implements proto.Message
+func (*QueryStatsResponse) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryStatsResponse) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryStatsResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryStatsResponse) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// QueryNonceRequest is the request type for the Query.Nonce RPC
+type QueryNonceRequest struct {
+	// Owner is the hex-encoded Ethereum address whose next expected nonce is
+	// requested
+	Owner string `json:"owner"`
+}
+
+// Reset implements proto.Message
+func (*QueryNonceRequest) Reset() {}
+
+// String implements proto.Message
+func (m *QueryNonceRequest) String() string { return "QueryNonceRequest{" + m.Owner + "}" }
+
+// ProtoMessage implements proto.Message
+func (*QueryNonceRequest) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryNonceRequest) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryNonceRequest) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryNonceRequest) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// QueryNonceResponse is the response type for the Query.Nonce RPC
+type QueryNonceResponse struct {
+	Nonce uint64 `json:"nonce"`
+}
+
+// Reset implements proto.Message
+func (*QueryNonceResponse) Reset() {}
+
+// String implements proto.Message
+func (*QueryNonceResponse) String() string { return "QueryNonceResponse" }
+
+// ProtoMessage implements proto.Message
+func (*QueryNonceResponse) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryNonceResponse) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryNonceResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryNonceResponse) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// QueryAllCountersRequest is the request type for the Query.AllCounters RPC
+type QueryAllCountersRequest struct {
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// Reset implements proto.Message
+func (*QueryAllCountersRequest) Reset() {}
+
+// String implements proto.Message
+func (*QueryAllCountersRequest) String() string { return "QueryAllCountersRequest" }
+
+// ProtoMessage implements proto.Message
+func (*QueryAllCountersRequest) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryAllCountersRequest) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryAllCountersRequest) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryAllCountersRequest) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// QueryAllCountersResponse is the response type for the Query.AllCounters RPC
+type QueryAllCountersResponse struct {
+	Counters   []GenesisCounter    `json:"counters"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// Reset implements proto.Message
+func (*QueryAllCountersResponse) Reset() {}
+
+// String implements proto.Message
+func (*QueryAllCountersResponse) String() string { return "QueryAllCountersResponse" }
+
+// ProtoMessage implements proto.Message
+func (*QueryAllCountersResponse) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface
+func (m *QueryAllCountersResponse) Marshal() ([]byte, error) { return marshalJSON(m) }
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (m *QueryAllCountersResponse) Unmarshal(bz []byte) error { return unmarshalJSON(bz, m) }
+
+// Size implements the gogoproto Marshaler interface
+func (m *QueryAllCountersResponse) Size() int { bz, _ := m.Marshal(); return len(bz) }
+
+// QueryServer is the gRPC query service for the counter module, backing
+// `appd query counter ...` and the REST routes registered by
+// RegisterGRPCGatewayRoutes.
+type QueryServer interface {
+	// Counter returns a single owner's counter value. It is the by-owner
+	// lookup now that counters are keyed per Ethereum address; there is no
+	// longer a single global counter to query.
+	Counter(context.Context, *QueryCounterRequest) (*QueryCounterResponse, error)
+	// Stats returns a single owner's counter statistics.
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	// Nonce returns the next expected nonce for an owner's Ethereum-signed
+	// counter txs, for clients building a MsgEthereumCounterTx.
+	Nonce(context.Context, *QueryNonceRequest) (*QueryNonceResponse, error)
+	// AllCounters returns every owner's counter, paginated.
+	AllCounters(context.Context, *QueryAllCountersRequest) (*QueryAllCountersResponse, error)
+}
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServer returns an implementation of QueryServer backed by k
+func NewQueryServer(k Keeper) QueryServer {
+	return &queryServer{Keeper: k}
+}
+
+func (q queryServer) Counter(c context.Context, req *QueryCounterRequest) (*QueryCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !common.IsHexAddress(req.Owner) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", req.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &QueryCounterResponse{Counter: q.Keeper.GetCounterFor(ctx, common.HexToAddress(req.Owner))}, nil
+}
+
+func (q queryServer) Stats(c context.Context, req *QueryStatsRequest) (*QueryStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !common.IsHexAddress(req.Owner) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", req.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &QueryStatsResponse{Stats: q.Keeper.GetStats(ctx, common.HexToAddress(req.Owner))}, nil
+}
+
+func (q queryServer) Nonce(c context.Context, req *QueryNonceRequest) (*QueryNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !common.IsHexAddress(req.Owner) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", req.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &QueryNonceResponse{Nonce: q.Keeper.GetNonce(ctx, common.HexToAddress(req.Owner))}, nil
+}
+
+func (q queryServer) AllCounters(c context.Context, req *QueryAllCountersRequest) (*QueryAllCountersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(q.Keeper.storeKey)
+	counterStore := prefix.NewStore(store, CounterKey)
+
+	var counters []GenesisCounter
+	pageRes, err := query.Paginate(counterStore, req.Pagination, func(key, value []byte) error {
+		var counter uint64
+		q.Keeper.cdc.MustUnmarshal(value, &counter)
+		counters = append(counters, GenesisCounter{
+			Owner:   common.BytesToAddress(key).Hex(),
+			Counter: counter,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QueryAllCountersResponse{Counters: counters, Pagination: pageRes}, nil
+}
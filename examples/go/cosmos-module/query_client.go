@@ -0,0 +1,60 @@
+package counter
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// QueryClient is the client API for the counter module's Query service, the
+// same shape protoc-gen-grpc would emit from query.proto. It's satisfied by
+// a client.Context, which implements grpc.ClientConn by dispatching Invoke
+// through the node's gRPC query route.
+type QueryClient interface {
+	Counter(ctx context.Context, in *QueryCounterRequest, opts ...grpc.CallOption) (*QueryCounterResponse, error)
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	Nonce(ctx context.Context, in *QueryNonceRequest, opts ...grpc.CallOption) (*QueryNonceResponse, error)
+	AllCounters(ctx context.Context, in *QueryAllCountersRequest, opts ...grpc.CallOption) (*QueryAllCountersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewQueryClient returns a QueryClient backed by cc, typically a
+// client.Context
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc: cc}
+}
+
+func (c *queryClient) Counter(ctx context.Context, in *QueryCounterRequest, opts ...grpc.CallOption) (*QueryCounterResponse, error) {
+	out := new(QueryCounterResponse)
+	if err := c.cc.Invoke(ctx, "/counter.v1.Query/Counter", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	if err := c.cc.Invoke(ctx, "/counter.v1.Query/Stats", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Nonce(ctx context.Context, in *QueryNonceRequest, opts ...grpc.CallOption) (*QueryNonceResponse, error) {
+	out := new(QueryNonceResponse)
+	if err := c.cc.Invoke(ctx, "/counter.v1.Query/Nonce", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllCounters(ctx context.Context, in *QueryAllCountersRequest, opts ...grpc.CallOption) (*QueryAllCountersResponse, error) {
+	out := new(QueryAllCountersResponse)
+	if err := c.cc.Invoke(ctx, "/counter.v1.Query/AllCounters", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
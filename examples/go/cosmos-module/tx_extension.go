@@ -0,0 +1,66 @@
+package counter
+
+import (
+	"github.com/gogo/protobuf/proto"
+)
+
+// ExtensionOptionsEthereumCounterTxTypeURL is the Any type URL under which
+// ExtensionOptionsEthereumCounterTx is packed into TxBody.extension_options.
+const ExtensionOptionsEthereumCounterTxTypeURL = "/" + ModuleName + ".v1.ExtensionOptionsEthereumCounterTx"
+
+func init() {
+	// codectypes.NewAnyWithValue derives TypeUrl from proto.MessageName, which
+	// consults gogoproto's global type registry; every generated .pb.go
+	// populates that registry from its init(). Without this call,
+	// proto.MessageName would return "" for this hand-written type and
+	// NewAnyWithValue would silently produce TypeUrl "/" instead of
+	// ExtensionOptionsEthereumCounterTxTypeURL.
+	proto.RegisterType((*ExtensionOptionsEthereumCounterTx)(nil), ExtensionOptionsEthereumCounterTxTypeURL[1:])
+}
+
+// ExtensionOptionsEthereumCounterTx is a marker extension option, mirroring
+// Ethermint's ExtensionOptionsEthereumTx: it carries no fields of its own.
+// Its presence in a tx's extension options tells EthereumCounterTxDecorator
+// that the tx's MsgEthereumCounterTx is authenticated by an Ethereum
+// signature rather than a standard Cosmos SDK one. The decorator still passes
+// the tx on to the rest of the ante chain afterwards; it does not itself
+// skip standard signature verification.
+type ExtensionOptionsEthereumCounterTx struct{}
+
+// Reset implements proto.Message
+func (*ExtensionOptionsEthereumCounterTx) Reset() {}
+
+// String implements proto.Message
+func (*ExtensionOptionsEthereumCounterTx) String() string {
+	return "ExtensionOptionsEthereumCounterTx{}"
+}
+
+// ProtoMessage implements proto.Message
+func (*ExtensionOptionsEthereumCounterTx) ProtoMessage() {}
+
+// Marshal implements the gogoproto Marshaler interface used by the Cosmos
+// SDK codec. The message carries no fields, so it always marshals to zero
+// bytes.
+func (*ExtensionOptionsEthereumCounterTx) Marshal() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// MarshalTo implements the gogoproto Marshaler interface
+func (m *ExtensionOptionsEthereumCounterTx) MarshalTo(data []byte) (int, error) {
+	return 0, nil
+}
+
+// MarshalToSizedBuffer implements the gogoproto Marshaler interface
+func (m *ExtensionOptionsEthereumCounterTx) MarshalToSizedBuffer(data []byte) (int, error) {
+	return 0, nil
+}
+
+// Size implements the gogoproto Marshaler interface
+func (*ExtensionOptionsEthereumCounterTx) Size() int {
+	return 0
+}
+
+// Unmarshal implements the gogoproto Marshaler interface
+func (*ExtensionOptionsEthereumCounterTx) Unmarshal(data []byte) error {
+	return nil
+}
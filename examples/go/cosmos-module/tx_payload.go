@@ -0,0 +1,244 @@
+package counter
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/pavlenkotm/tutorials-account-balances/examples/go/cosmos-module/eip712"
+)
+
+// CounterAction identifies which Keeper mutation an Ethereum-signed counter
+// tx requests.
+type CounterAction uint8
+
+const (
+	ActionIncrement CounterAction = iota
+	ActionDecrement
+	ActionIncrementBy
+	ActionSet
+	ActionReset
+)
+
+// CounterTxPayload is the RLP-encoded body of a MsgEthereumCounterTx. It
+// mirrors the shape of a go-ethereum legacy transaction: the signature
+// (V, R, S) is computed over the canonical encoding of Action, Amount and
+// Nonce (see SigningHash); Typed and Owner are not part of that encoding.
+//
+// Typed and Owner exist to support EIP-712 signing (see the eip712
+// subpackage): when Typed is set, the signature was produced over the
+// EIP-712 digest of a CounterOp{Action, Amount, Nonce, Owner} rather than
+// over SigningHash, and Owner carries the address the signer claims to be so
+// the ante handler can reconstruct that digest without already knowing the
+// signer.
+type CounterTxPayload struct {
+	Action CounterAction
+	Amount uint64
+	Nonce  uint64
+	Typed  bool
+	Owner  common.Address
+	V      *big.Int
+	R      *big.Int
+	S      *big.Int
+}
+
+// actionName returns the EIP-712 CounterOp.Action string for a CounterAction
+func actionName(action CounterAction) (string, error) {
+	switch action {
+	case ActionIncrement:
+		return "increment", nil
+	case ActionDecrement:
+		return "decrement", nil
+	case ActionIncrementBy:
+		return "increment_by", nil
+	case ActionSet:
+		return "set", nil
+	case ActionReset:
+		return "reset", nil
+	default:
+		return "", fmt.Errorf("unknown counter action %d", action)
+	}
+}
+
+// signingFields is the subset of CounterTxPayload that is hashed and signed;
+// it excludes Typed, Owner and V, R, S themselves.
+type signingFields struct {
+	Action CounterAction
+	Amount uint64
+	Nonce  uint64
+}
+
+// SigningHash returns the Keccak256 hash of the payload's canonical encoding,
+// i.e. the digest that crypto.Sign / crypto.Ecrecover operate over.
+func (p *CounterTxPayload) SigningHash() common.Hash {
+	return rlpHash(signingFields{
+		Action: p.Action,
+		Amount: p.Amount,
+		Nonce:  p.Nonce,
+	})
+}
+
+// secp256k1N is the order of the secp256k1 curve; valid R and S values (and,
+// by extension, any decoded off the wire) must fall in [1, secp256k1N-1].
+var secp256k1N = crypto.S256().Params().N
+
+// Signature returns the 65-byte [R || S || V] signature in the format
+// crypto.Ecrecover expects. It mirrors go-ethereum's own
+// checkSignatureValues: R and S are RLP-decoded as unbounded big.Ints off
+// attacker-controlled wire data, so both must be range-checked before they
+// are copied into a fixed-size buffer, or an oversized value panics with a
+// negative slice index instead of failing cleanly.
+func (p *CounterTxPayload) Signature() ([]byte, error) {
+	if p.R == nil || p.S == nil || p.V == nil {
+		return nil, fmt.Errorf("counter tx payload is unsigned")
+	}
+
+	if p.R.Sign() <= 0 || p.S.Sign() <= 0 || p.R.Cmp(secp256k1N) >= 0 || p.S.Cmp(secp256k1N) >= 0 {
+		return nil, fmt.Errorf("invalid signature: R and S must be in [1, N-1]")
+	}
+
+	sig := make([]byte, 65)
+	copy(sig[32-len(p.R.Bytes()):32], p.R.Bytes())
+	copy(sig[64-len(p.S.Bytes()):64], p.S.Bytes())
+	sig[64] = byte(p.V.Uint64())
+	return sig, nil
+}
+
+// Recover recovers the Ethereum address that signed this payload over its
+// raw SigningHash. It is an error to call this on a payload signed via
+// EIP-712 (Typed == true); use RecoverWithDomain for those instead.
+func (p *CounterTxPayload) Recover() (common.Address, error) {
+	if p.Typed {
+		return common.Address{}, fmt.Errorf("counter tx payload uses EIP-712 signing, call RecoverWithDomain")
+	}
+
+	sig, err := p.Signature()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	pubKey, err := crypto.Ecrecover(p.SigningHash().Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+
+	pub, err := crypto.UnmarshalPubkey(pubKey)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to unmarshal recovered public key: %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// RecoverWithDomain recovers the Ethereum address that signed this payload,
+// honoring Typed: if set, it verifies the EIP-712 signature over
+// CounterOp{Action, Amount, Nonce, Owner} under domain and requires the
+// recovered address to match the claimed Owner; otherwise it falls back to
+// the raw Recover path.
+func (p *CounterTxPayload) RecoverWithDomain(domain eip712.Domain) (common.Address, error) {
+	if !p.Typed {
+		return p.Recover()
+	}
+
+	sig, err := p.Signature()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	action, err := actionName(p.Action)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	op := eip712.CounterOp{Action: action, Amount: p.Amount, Nonce: p.Nonce, Owner: p.Owner}
+
+	recovered, err := eip712.RecoverTypedData(domain, op, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover EIP-712 signer: %w", err)
+	}
+	if recovered != p.Owner {
+		return common.Address{}, fmt.Errorf("EIP-712 signature does not match claimed owner %s", p.Owner.Hex())
+	}
+
+	return recovered, nil
+}
+
+// SignCounterTxPayload builds and signs a CounterTxPayload for action/amount
+// at the given nonce using a raw ECDSA private key.
+func SignCounterTxPayload(privateKey *ecdsa.PrivateKey, action CounterAction, amount, nonce uint64) (*CounterTxPayload, error) {
+	payload := &CounterTxPayload{
+		Action: action,
+		Amount: amount,
+		Nonce:  nonce,
+	}
+
+	sig, err := crypto.Sign(payload.SigningHash().Bytes(), privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign counter tx payload: %w", err)
+	}
+
+	payload.R = new(big.Int).SetBytes(sig[:32])
+	payload.S = new(big.Int).SetBytes(sig[32:64])
+	payload.V = new(big.Int).SetUint64(uint64(sig[64]))
+	return payload, nil
+}
+
+// SignCounterTxPayloadTyped is SignCounterTxPayload's EIP-712 counterpart:
+// it signs the CounterOp{Action, Amount, Nonce, Owner} typed struct under
+// domain, so a wallet can show a readable prompt instead of an opaque hash.
+func SignCounterTxPayloadTyped(privateKey *ecdsa.PrivateKey, domain eip712.Domain, action CounterAction, amount, nonce uint64) (*CounterTxPayload, error) {
+	owner := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	name, err := actionName(action)
+	if err != nil {
+		return nil, err
+	}
+
+	op := eip712.CounterOp{Action: name, Amount: amount, Nonce: nonce, Owner: owner}
+	sig, err := eip712.SignTypedData(privateKey, domain, op)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign counter tx payload: %w", err)
+	}
+
+	return &CounterTxPayload{
+		Action: action,
+		Amount: amount,
+		Nonce:  nonce,
+		Typed:  true,
+		Owner:  owner,
+		R:      new(big.Int).SetBytes(sig[:32]),
+		S:      new(big.Int).SetBytes(sig[32:64]),
+		V:      new(big.Int).SetUint64(uint64(sig[64])),
+	}, nil
+}
+
+// EncodeCounterTxPayload RLP-encodes payload for use as MsgEthereumCounterTx.Data
+func EncodeCounterTxPayload(payload *CounterTxPayload) ([]byte, error) {
+	return rlp.EncodeToBytes(payload)
+}
+
+// DecodeCounterTxPayload RLP-decodes a MsgEthereumCounterTx.Data field back
+// into a CounterTxPayload
+func DecodeCounterTxPayload(data []byte) (*CounterTxPayload, error) {
+	var payload CounterTxPayload
+	if err := rlp.DecodeBytes(data, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode counter tx payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// rlpHash mirrors go-ethereum's types.rlpHash: the Keccak256 hash of val's
+// RLP encoding.
+func rlpHash(val interface{}) common.Hash {
+	hasher := sha3.NewLegacyKeccak256()
+	_ = rlp.Encode(hasher, val)
+
+	var hash common.Hash
+	hasher.Sum(hash[:0])
+	return hash
+}
@@ -20,8 +20,32 @@ const (
 
 // Store keys
 var (
-	// CounterKey is the key for storing the counter value
+	// CounterKey is the prefix under which per-owner counters are stored,
+	// as CounterKey || ethAddr[20]. Prior to the per-owner migration this
+	// key held a single global uint64 directly; see MigrateToPerOwnerCounters.
 	CounterKey = []byte{0x01}
+
+	// NonceKey is the prefix under which the next expected nonce for an
+	// Ethereum-signed counter tx is stored, as NonceKey || ethAddr[20].
+	// It guards MsgEthereumCounterTx against replay.
+	NonceKey = []byte{0x02}
+
+	// HistoryKey is the prefix under which per-mutation Events are stored,
+	// as HistoryKey || bigendian(height) || bigendian(seq). The height
+	// component lets GetHistory and the BeginBlocker pruning hook address a
+	// contiguous range without scanning the whole keyspace.
+	HistoryKey = []byte{0x03}
+
+	// HistorySeqKey stores the monotonic sequence counter used to keep
+	// same-height HistoryKey entries ordered and collision-free.
+	HistorySeqKey = []byte{0x04}
+
+	// AggregatesKey stores the single rolling Aggregates value maintained
+	// alongside the per-mutation history.
+	AggregatesKey = []byte{0x05}
+
+	// ParamsKey stores the module's Params, notably HistoryWindowBlocks.
+	ParamsKey = []byte{0x06}
 )
 
 // Event types
@@ -35,10 +59,12 @@ const (
 	AttributeKeyCounter = "counter_value"
 	AttributeKeyAmount  = "amount"
 	AttributeKeyAction  = "action"
+	AttributeKeyOwner   = "owner"
 )
 
-// Stats represents counter statistics
+// Stats represents counter statistics for a single owner
 type Stats struct {
+	Owner       string    `json:"owner"`
 	Counter     uint64    `json:"counter"`
 	BlockHeight int64     `json:"block_height"`
 	BlockTime   time.Time `json:"block_time"`
@@ -53,3 +79,40 @@ type QueryCounterResponse struct {
 type QueryStatsResponse struct {
 	Stats Stats `json:"stats"`
 }
+
+// Event is a single recorded counter mutation, appended to the HistoryKey
+// keyspace on every successful Keeper mutation.
+type Event struct {
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+	Delta  int64     `json:"delta"`
+	Action string    `json:"action"`
+	Owner  string    `json:"owner"`
+}
+
+// Aggregates are the rolling, all-time counter statistics maintained
+// alongside the per-mutation history.
+type Aggregates struct {
+	TotalIncrements uint64 `json:"total_increments"`
+	TotalDecrements uint64 `json:"total_decrements"`
+	SumOfAmounts    uint64 `json:"sum_of_amounts"`
+	MaxValueSeen    uint64 `json:"max_value_seen"`
+	UniqueOwners    uint64 `json:"unique_owners"`
+}
+
+// DefaultHistoryWindowBlocks is how many blocks of history the module
+// retains when no other value has been configured via Params.
+const DefaultHistoryWindowBlocks = int64(100_000)
+
+// Params are the counter module's configurable parameters
+type Params struct {
+	// HistoryWindowBlocks is how many blocks of History entries to retain;
+	// the BeginBlocker pruning hook drops anything older. A value <= 0
+	// disables pruning.
+	HistoryWindowBlocks int64 `json:"history_window_blocks"`
+}
+
+// DefaultParams returns the module's default Params
+func DefaultParams() Params {
+	return Params{HistoryWindowBlocks: DefaultHistoryWindowBlocks}
+}